@@ -0,0 +1,77 @@
+// Package buncli holds the logic behind the `bun` command so that projects
+// can import it, set Connect and Migrations from their own main package, and
+// get the "db migrate/rollback/status" and "gen models" commands without
+// re-implementing them. cmd/bun is a thin, unconfigured wrapper around Run.
+package buncli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+)
+
+// Connect opens the *bun.DB the "db" subcommands operate on. Projects must
+// set this (typically from their own main's init) before calling Run with a
+// "db" command.
+var Connect func(ctx context.Context) (*bun.DB, error)
+
+// Migrations is registered with the migrator before every "db" subcommand
+// runs. Projects populate it with their own migrate.Migration values.
+var Migrations []*migrate.Migration
+
+// Run dispatches a bun CLI invocation, e.g. Run(ctx, os.Args[1:]).
+func Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: bun <command> [args]")
+	}
+
+	switch args[0] {
+	case "db":
+		return runDB(ctx, args[1:])
+	case "gen":
+		return runGen(ctx, args[1:])
+	default:
+		return fmt.Errorf("bun: unknown command %q", args[0])
+	}
+}
+
+func runDB(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: bun db <migrate|rollback|status>")
+	}
+	if Connect == nil {
+		return fmt.Errorf("bun: no database configured, set buncli.Connect before calling buncli.Run")
+	}
+
+	db, err := Connect(ctx)
+	if err != nil {
+		return fmt.Errorf("bun: connect: %w", err)
+	}
+	defer db.Close()
+
+	m := migrate.New(db).Register(Migrations...)
+
+	switch args[0] {
+	case "migrate":
+		return m.Migrate(ctx)
+	case "rollback":
+		return m.Rollback(ctx)
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%s\t%s\n", s.ID, state)
+		}
+		return nil
+	default:
+		return fmt.Errorf("bun db: unknown command %q", args[0])
+	}
+}
@@ -0,0 +1,91 @@
+package buncli
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/uptrace/bun/dbgen"
+)
+
+// driverNames maps a --dialect value to the database/sql driver name it
+// needs registered (via a blank import) to work with sql.Open.
+var driverNames = map[string]string{
+	"postgres": "postgres",
+	"mysql":    "mysql",
+	"sqlite":   "sqlite3",
+	"sqlite3":  "sqlite3",
+}
+
+func runGen(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: bun gen <models>")
+	}
+
+	switch args[0] {
+	case "models":
+		return runGenModels(ctx, args[1:])
+	default:
+		return fmt.Errorf("bun gen: unknown command %q", args[0])
+	}
+}
+
+func runGenModels(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("bun gen models", flag.ExitOnError)
+	dialect := fs.String("dialect", "", "postgres, mysql or sqlite")
+	dsn := fs.String("dsn", "", "data source name")
+	pkg := fs.String("package", "models", "package name for the generated file")
+	out := fs.String("out", "models_gen.go", "output file path")
+	blacklist := fs.String("blacklist", "", "comma-separated list of tables to skip")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	driver, ok := driverNames[*dialect]
+	if !ok {
+		return fmt.Errorf("bun gen models: unsupported dialect %q", *dialect)
+	}
+
+	sqldb, err := sql.Open(driver, *dsn)
+	if err != nil {
+		return fmt.Errorf(
+			"bun gen models: open %s (driver %q): %w — is it blank-imported in your main package?",
+			*dialect, driver, err,
+		)
+	}
+	defer sqldb.Close()
+
+	var introspector dbgen.Introspector
+	switch *dialect {
+	case "postgres":
+		introspector = &dbgen.PostgresIntrospector{DB: sqldb}
+	case "mysql":
+		introspector = &dbgen.MySQLIntrospector{DB: sqldb}
+	case "sqlite", "sqlite3":
+		introspector = &dbgen.SQLiteIntrospector{DB: sqldb}
+	}
+
+	opt := dbgen.Options{PackageName: *pkg}
+	if *blacklist != "" {
+		opt.Blacklist = strings.Split(*blacklist, ",")
+	}
+
+	src, err := dbgen.Generate(ctx, introspector, opt)
+	if err != nil {
+		return fmt.Errorf("bun gen models: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		return fmt.Errorf("bun gen models: %w", err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		return fmt.Errorf("bun gen models: %w", err)
+	}
+
+	fmt.Printf("wrote %s\n", *out)
+	return nil
+}
@@ -0,0 +1,27 @@
+// Command bun is a small helper CLI for bun projects. It blank-imports the
+// postgres, mysql and sqlite3 database/sql drivers so "bun gen models" works
+// out of the box against any of them. The "db migrate/rollback/status"
+// commands require Connect and Migrations to be set from Go, so projects
+// that want those should write their own main importing
+// github.com/uptrace/bun/buncli and setting them before calling
+// buncli.Run, instead of using this binary directly.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/uptrace/bun/buncli"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func main() {
+	if err := buncli.Run(context.Background(), os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
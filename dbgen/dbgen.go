@@ -0,0 +1,60 @@
+// Package dbgen reverse-engineers an existing database into bun model
+// structs, similar in spirit to bee's code generator for Beego models.
+package dbgen
+
+import "context"
+
+// Table describes a single database table discovered by an Introspector.
+type Table struct {
+	Schema string
+	Name   string
+}
+
+// Column describes a single column of a Table.
+type Column struct {
+	Name       string
+	SQLType    string
+	IsNullable bool
+	IsPK       bool
+	IsIdentity bool // auto-increment / serial / identity column
+	Default    string
+}
+
+// Constraint describes a foreign key constraint on a Table.
+type Constraint struct {
+	Name       string
+	Columns    []string
+	RefSchema  string
+	RefTable   string
+	RefColumns []string
+}
+
+// Introspector reads table metadata out of a database's information schema
+// (or dialect-specific equivalent, e.g. SQLite's PRAGMA statements).
+type Introspector interface {
+	Tables(ctx context.Context) ([]Table, error)
+	Columns(ctx context.Context, table Table) ([]Column, error)
+	Constraints(ctx context.Context, table Table) ([]Constraint, error)
+}
+
+// Options configures Generate.
+type Options struct {
+	// PackageName is the package name written to the top of the generated file.
+	PackageName string
+
+	// Blacklist excludes tables by name from generation.
+	Blacklist []string
+
+	// TypeOverrides maps a SQL type to a Go type, taking precedence over the
+	// built-in defaultGoType mapping.
+	TypeOverrides map[string]string
+}
+
+func (o *Options) blacklisted(name string) bool {
+	for _, excluded := range o.Blacklist {
+		if excluded == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package dbgen
+
+import (
+	"context"
+	"database/sql"
+)
+
+// MySQLIntrospector implements Introspector against MySQL's
+// information_schema.
+type MySQLIntrospector struct {
+	DB     *sql.DB
+	Schema string // defaults to the connection's current database if empty
+}
+
+var _ Introspector = (*MySQLIntrospector)(nil)
+
+func (i *MySQLIntrospector) schema(ctx context.Context) (string, error) {
+	if i.Schema != "" {
+		return i.Schema, nil
+	}
+	var schema string
+	err := i.DB.QueryRowContext(ctx, "SELECT DATABASE()").Scan(&schema)
+	return schema, err
+}
+
+func (i *MySQLIntrospector) Tables(ctx context.Context) ([]Table, error) {
+	schema, err := i.schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := i.DB.QueryContext(ctx, `
+		SELECT table_schema, table_name
+		FROM information_schema.tables
+		WHERE table_schema = ? AND table_type = 'BASE TABLE'
+		ORDER BY table_name
+	`, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []Table
+	for rows.Next() {
+		var t Table
+		if err := rows.Scan(&t.Schema, &t.Name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	return tables, rows.Err()
+}
+
+func (i *MySQLIntrospector) Columns(ctx context.Context, table Table) ([]Column, error) {
+	rows, err := i.DB.QueryContext(ctx, `
+		SELECT
+			column_name,
+			data_type,
+			is_nullable = 'YES',
+			extra LIKE '%auto_increment%',
+			column_key = 'PRI',
+			COALESCE(column_default, '')
+		FROM information_schema.columns
+		WHERE table_schema = ? AND table_name = ?
+		ORDER BY ordinal_position
+	`, table.Schema, table.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []Column
+	for rows.Next() {
+		var col Column
+		if err := rows.Scan(
+			&col.Name, &col.SQLType, &col.IsNullable, &col.IsIdentity, &col.IsPK, &col.Default,
+		); err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+	return columns, rows.Err()
+}
+
+func (i *MySQLIntrospector) Constraints(ctx context.Context, table Table) ([]Constraint, error) {
+	rows, err := i.DB.QueryContext(ctx, `
+		SELECT constraint_name, column_name, referenced_table_schema, referenced_table_name, referenced_column_name
+		FROM information_schema.key_column_usage
+		WHERE table_schema = ? AND table_name = ? AND referenced_table_name IS NOT NULL
+		ORDER BY constraint_name, ordinal_position
+	`, table.Schema, table.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byName := make(map[string]*Constraint)
+	var order []string
+	for rows.Next() {
+		var name, column, refSchema, refTable, refColumn string
+		if err := rows.Scan(&name, &column, &refSchema, &refTable, &refColumn); err != nil {
+			return nil, err
+		}
+
+		c, ok := byName[name]
+		if !ok {
+			c = &Constraint{Name: name, RefSchema: refSchema, RefTable: refTable}
+			byName[name] = c
+			order = append(order, name)
+		}
+		c.Columns = append(c.Columns, column)
+		c.RefColumns = append(c.RefColumns, refColumn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	constraints := make([]Constraint, 0, len(order))
+	for _, name := range order {
+		constraints = append(constraints, *byName[name])
+	}
+	return constraints, nil
+}
@@ -0,0 +1,128 @@
+package dbgen
+
+import (
+	"context"
+	"database/sql"
+)
+
+// PostgresIntrospector implements Introspector against PostgreSQL's
+// information_schema.
+type PostgresIntrospector struct {
+	DB *sql.DB
+}
+
+var _ Introspector = (*PostgresIntrospector)(nil)
+
+func (i *PostgresIntrospector) Tables(ctx context.Context) ([]Table, error) {
+	rows, err := i.DB.QueryContext(ctx, `
+		SELECT table_schema, table_name
+		FROM information_schema.tables
+		WHERE table_schema NOT IN ('pg_catalog', 'information_schema')
+		  AND table_type = 'BASE TABLE'
+		ORDER BY table_schema, table_name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []Table
+	for rows.Next() {
+		var t Table
+		if err := rows.Scan(&t.Schema, &t.Name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	return tables, rows.Err()
+}
+
+func (i *PostgresIntrospector) Columns(ctx context.Context, table Table) ([]Column, error) {
+	rows, err := i.DB.QueryContext(ctx, `
+		SELECT
+			c.column_name,
+			c.udt_name,
+			c.is_nullable = 'YES',
+			COALESCE(c.column_default LIKE 'nextval(%', false) OR c.is_identity = 'YES',
+			pk.column_name IS NOT NULL,
+			COALESCE(c.column_default, '')
+		FROM information_schema.columns c
+		LEFT JOIN (
+			SELECT kcu.column_name
+			FROM information_schema.table_constraints tc
+			JOIN information_schema.key_column_usage kcu
+				ON kcu.constraint_name = tc.constraint_name
+				AND kcu.table_schema = tc.table_schema
+			WHERE tc.constraint_type = 'PRIMARY KEY'
+				AND tc.table_schema = $1 AND tc.table_name = $2
+		) pk ON pk.column_name = c.column_name
+		WHERE c.table_schema = $1 AND c.table_name = $2
+		ORDER BY c.ordinal_position
+	`, table.Schema, table.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []Column
+	for rows.Next() {
+		var col Column
+		if err := rows.Scan(
+			&col.Name, &col.SQLType, &col.IsNullable, &col.IsIdentity, &col.IsPK, &col.Default,
+		); err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+	return columns, rows.Err()
+}
+
+func (i *PostgresIntrospector) Constraints(ctx context.Context, table Table) ([]Constraint, error) {
+	rows, err := i.DB.QueryContext(ctx, `
+		SELECT
+			tc.constraint_name,
+			kcu.column_name,
+			ccu.table_schema,
+			ccu.table_name,
+			ccu.column_name
+		FROM information_schema.table_constraints tc
+		JOIN information_schema.key_column_usage kcu
+			ON kcu.constraint_name = tc.constraint_name AND kcu.table_schema = tc.table_schema
+		JOIN information_schema.constraint_column_usage ccu
+			ON ccu.constraint_name = tc.constraint_name AND ccu.table_schema = tc.table_schema
+		WHERE tc.constraint_type = 'FOREIGN KEY'
+			AND tc.table_schema = $1 AND tc.table_name = $2
+		ORDER BY tc.constraint_name, kcu.ordinal_position
+	`, table.Schema, table.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byName := make(map[string]*Constraint)
+	var order []string
+	for rows.Next() {
+		var name, column, refSchema, refTable, refColumn string
+		if err := rows.Scan(&name, &column, &refSchema, &refTable, &refColumn); err != nil {
+			return nil, err
+		}
+
+		c, ok := byName[name]
+		if !ok {
+			c = &Constraint{Name: name, RefSchema: refSchema, RefTable: refTable}
+			byName[name] = c
+			order = append(order, name)
+		}
+		c.Columns = append(c.Columns, column)
+		c.RefColumns = append(c.RefColumns, refColumn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	constraints := make([]Constraint, 0, len(order))
+	for _, name := range order {
+		constraints = append(constraints, *byName[name])
+	}
+	return constraints, nil
+}
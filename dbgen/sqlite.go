@@ -0,0 +1,113 @@
+package dbgen
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// SQLiteIntrospector implements Introspector against SQLite's sqlite_master
+// table and PRAGMA statements.
+type SQLiteIntrospector struct {
+	DB *sql.DB
+}
+
+var _ Introspector = (*SQLiteIntrospector)(nil)
+
+func (i *SQLiteIntrospector) Tables(ctx context.Context) ([]Table, error) {
+	rows, err := i.DB.QueryContext(ctx, `
+		SELECT name FROM sqlite_master
+		WHERE type = 'table' AND name NOT LIKE 'sqlite_%'
+		ORDER BY name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []Table
+	for rows.Next() {
+		var t Table
+		if err := rows.Scan(&t.Name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	return tables, rows.Err()
+}
+
+func (i *SQLiteIntrospector) Columns(ctx context.Context, table Table) ([]Column, error) {
+	rows, err := i.DB.QueryContext(ctx, `PRAGMA table_info(`+quoteIdent(table.Name)+`)`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []Column
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			sqlType    string
+			notNull    int
+			dflt       sql.NullString
+			pk         int
+		)
+		if err := rows.Scan(&cid, &name, &sqlType, &notNull, &dflt, &pk); err != nil {
+			return nil, err
+		}
+
+		columns = append(columns, Column{
+			Name:       name,
+			SQLType:    sqlType,
+			IsNullable: notNull == 0,
+			IsPK:       pk > 0,
+			IsIdentity: pk > 0 && strings.EqualFold(sqlType, "integer"),
+			Default:    dflt.String,
+		})
+	}
+	return columns, rows.Err()
+}
+
+func (i *SQLiteIntrospector) Constraints(ctx context.Context, table Table) ([]Constraint, error) {
+	rows, err := i.DB.QueryContext(ctx, `PRAGMA foreign_key_list(`+quoteIdent(table.Name)+`)`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byID := make(map[int]*Constraint)
+	var order []int
+	for rows.Next() {
+		var (
+			id, seq                          int
+			refTable, from, to, onUpdate     string
+			onDelete, match                  string
+		)
+		if err := rows.Scan(&id, &seq, &refTable, &from, &to, &onUpdate, &onDelete, &match); err != nil {
+			return nil, err
+		}
+
+		c, ok := byID[id]
+		if !ok {
+			c = &Constraint{RefTable: refTable}
+			byID[id] = c
+			order = append(order, id)
+		}
+		c.Columns = append(c.Columns, from)
+		c.RefColumns = append(c.RefColumns, to)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	constraints := make([]Constraint, 0, len(order))
+	for _, id := range order {
+		constraints = append(constraints, *byID[id])
+	}
+	return constraints, nil
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
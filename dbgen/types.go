@@ -0,0 +1,63 @@
+package dbgen
+
+import "strings"
+
+// defaultGoType maps common SQL types to their idiomatic Go equivalent. It is
+// intentionally conservative: anything unrecognized falls back to "string"
+// rather than guessing wrong.
+var defaultGoType = map[string]string{
+	"smallint": "int16",
+	"int2":     "int16",
+	"integer":  "int32",
+	"int":      "int32",
+	"int4":     "int32",
+	"bigint":   "int64",
+	"int8":     "int64",
+	"serial":   "int32",
+	"bigserial": "int64",
+	"real":      "float32",
+	"float4":    "float32",
+	"double precision": "float64",
+	"float8":           "float64",
+	"numeric":          "float64",
+	"decimal":          "float64",
+	"boolean":          "bool",
+	"bool":             "bool",
+	"text":             "string",
+	"varchar":          "string",
+	"character varying": "string",
+	"char":               "string",
+	"uuid":               "string",
+	"json":               "string",
+	"jsonb":              "string",
+	"bytea":              "[]byte",
+	"blob":               "[]byte",
+	"date":               "time.Time",
+	"timestamp":          "time.Time",
+	"timestamptz":        "time.Time",
+	"timestamp with time zone":    "time.Time",
+	"timestamp without time zone": "time.Time",
+	"datetime":                    "time.Time",
+}
+
+// goType resolves sqlType to a Go type, consulting overrides first, then the
+// built-in table, then falling back to "string" for anything unknown.
+func goType(sqlType string, nullable bool, overrides map[string]string) string {
+	key := strings.ToLower(strings.TrimSpace(sqlType))
+	if i := strings.IndexByte(key, '('); i >= 0 {
+		key = strings.TrimSpace(key[:i])
+	}
+
+	typ, ok := overrides[key]
+	if !ok {
+		typ, ok = defaultGoType[key]
+	}
+	if !ok {
+		typ = "string"
+	}
+
+	if nullable {
+		return "*" + typ
+	}
+	return typ
+}
@@ -0,0 +1,30 @@
+// Package feature enumerates optional SQL capabilities that vary across
+// bun's supported dialects. Dialects report the features they support when
+// constructed, and callers gate dialect-specific SQL behind Feature.Has
+// instead of switching on dialect.Name().
+package feature
+
+// Feature is a bitmask of optional capabilities a dialect may support.
+type Feature uint64
+
+// Has reports whether f has every bit set in other.
+func (f Feature) Has(other Feature) bool {
+	return f&other == other
+}
+
+// GeneratedColumns and DropIndexRequiresTable extend the dialect feature
+// bitmask for CreateTableQuery's `generated:` tag support and
+// DropIndexQuery's MySQL-style "ON table_name" clause. They start at a high
+// bit so they don't collide with the flags already assigned to this
+// package's existing capabilities (e.g. AutoIncrement).
+const (
+	// GeneratedColumns indicates the dialect supports
+	// GENERATED ALWAYS AS (expr) [STORED|VIRTUAL] columns. pgdialect,
+	// mysqldialect and sqlitedialect all set this.
+	GeneratedColumns Feature = 1 << 40
+
+	// DropIndexRequiresTable indicates the dialect's DROP INDEX statement
+	// requires an "ON table_name" clause, as MySQL does. Only
+	// mysqldialect sets this; DropIndexQuery.On stays optional elsewhere.
+	DropIndexRequiresTable Feature = 1 << 41
+)
@@ -0,0 +1,82 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// Discover loads *.up.sql / *.down.sql migration pairs from dir in fsys and
+// returns them as Migrations ready to pass to Register. The migration ID is
+// the filename with the .up.sql / .down.sql suffix stripped, e.g.
+// "20240101120000_create_users.up.sql" and "20240101120000_create_users.down.sql"
+// both become migration "20240101120000_create_users".
+func Discover(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: read migrations dir %q: %w", dir, err)
+	}
+
+	ups := make(map[string]string)
+	downs := make(map[string]string)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		name := entry.Name()
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			ups[strings.TrimSuffix(name, ".up.sql")] = path.Join(dir, name)
+		case strings.HasSuffix(name, ".down.sql"):
+			downs[strings.TrimSuffix(name, ".down.sql")] = path.Join(dir, name)
+		}
+	}
+
+	ids := make([]string, 0, len(ups))
+	for id := range ups {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	migrations := make([]*Migration, 0, len(ids))
+	for _, id := range ids {
+		id := id
+		upPath := ups[id]
+		downPath, hasDown := downs[id]
+
+		migrations = append(migrations, &Migration{
+			ID: id,
+			Up: func(ctx context.Context, tx bun.Tx) error {
+				return execSQLFile(ctx, tx, fsys, upPath)
+			},
+			Down: func(ctx context.Context, tx bun.Tx) error {
+				if !hasDown {
+					return fmt.Errorf("migrate: no down migration for %q", id)
+				}
+				return execSQLFile(ctx, tx, fsys, downPath)
+			},
+		})
+	}
+
+	return migrations, nil
+}
+
+func execSQLFile(ctx context.Context, tx bun.Tx, fsys fs.FS, name string) error {
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return fmt.Errorf("migrate: read %q: %w", name, err)
+	}
+
+	_, err = tx.ExecContext(ctx, string(b))
+	if err != nil {
+		return fmt.Errorf("migrate: exec %q: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Migration is a single registered migration step, identified by a unique,
+// sortable ID (by convention a timestamp prefix, e.g. "20240101120000_create_users").
+type Migration struct {
+	ID   string
+	Up   func(ctx context.Context, tx bun.Tx) error
+	Down func(ctx context.Context, tx bun.Tx) error
+}
+
+type migrationRow struct {
+	bun.BaseModel `bun:"table:bun_migrations,alias:m"`
+
+	ID        string    `bun:"id,pk"`
+	Batch     int64     `bun:"batch,notnull"`
+	AppliedAt time.Time `bun:"applied_at,notnull,default:current_timestamp"`
+}
+
+// Migrator applies and rolls back a set of registered Migrations, recording
+// progress in a bun_migrations table.
+type Migrator struct {
+	db         *bun.DB
+	tableName  string
+	migrations []*Migration
+}
+
+// New creates a Migrator bound to db. Migrations must be added with Register
+// before calling Migrate, Rollback or Status.
+func New(db *bun.DB) *Migrator {
+	return &Migrator{
+		db:        db,
+		tableName: "bun_migrations",
+	}
+}
+
+// Register adds migrations to the migrator. It panics if two migrations
+// share the same ID.
+func (m *Migrator) Register(migrations ...*Migration) *Migrator {
+	seen := make(map[string]struct{}, len(m.migrations))
+	for _, existing := range m.migrations {
+		seen[existing.ID] = struct{}{}
+	}
+	for _, migration := range migrations {
+		if _, ok := seen[migration.ID]; ok {
+			panic(fmt.Errorf("migrate: migration %q is already registered", migration.ID))
+		}
+		seen[migration.ID] = struct{}{}
+		m.migrations = append(m.migrations, migration)
+	}
+	return m
+}
+
+func (m *Migrator) sorted() []*Migration {
+	sorted := make([]*Migration, len(m.migrations))
+	copy(sorted, m.migrations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+func (m *Migrator) init(ctx context.Context) error {
+	_, err := m.db.NewCreateTable().
+		Model((*migrationRow)(nil)).
+		ModelTableExpr(m.tableName).
+		IfNotExists().
+		Exec(ctx)
+	return err
+}
+
+func (m *Migrator) rows(ctx context.Context) ([]migrationRow, error) {
+	var rows []migrationRow
+	if err := m.db.NewSelect().
+		Model(&rows).
+		ModelTableExpr(m.tableName).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[string]bool, error) {
+	rows, err := m.rows(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		applied[row.ID] = true
+	}
+	return applied, nil
+}
+
+// lastBatch returns the highest batch number recorded so far, or 0 if no
+// migration has ever been applied.
+func (m *Migrator) lastBatch(ctx context.Context) (int64, error) {
+	rows, err := m.rows(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var last int64
+	for _, row := range rows {
+		if row.Batch > last {
+			last = row.Batch
+		}
+	}
+	return last, nil
+}
+
+// Migrate applies every registered migration that has not yet been recorded
+// in the migrations table, in ID order, each inside its own transaction. All
+// migrations applied by a single Migrate call share the same batch number,
+// so Rollback can undo them together.
+func (m *Migrator) Migrate(ctx context.Context) error {
+	if err := m.init(ctx); err != nil {
+		return fmt.Errorf("migrate: init migrations table: %w", err)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: load applied migrations: %w", err)
+	}
+
+	lastBatch, err := m.lastBatch(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: load last batch: %w", err)
+	}
+	batch := lastBatch + 1
+
+	for _, migration := range m.sorted() {
+		if applied[migration.ID] {
+			continue
+		}
+
+		err := m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if migration.Up != nil {
+				if err := migration.Up(ctx, tx); err != nil {
+					return err
+				}
+			}
+
+			_, err := tx.NewInsert().
+				Model(&migrationRow{ID: migration.ID, Batch: batch, AppliedAt: time.Now()}).
+				ModelTableExpr(m.tableName).
+				Exec(ctx)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migrate: apply %q: %w", migration.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Rollback runs the Down step of every migration in the most recent batch,
+// in reverse ID order, and removes their rows from the migrations table. The
+// whole batch is rolled back inside a single transaction.
+func (m *Migrator) Rollback(ctx context.Context) error {
+	if err := m.init(ctx); err != nil {
+		return fmt.Errorf("migrate: init migrations table: %w", err)
+	}
+
+	lastBatch, err := m.lastBatch(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: load last batch: %w", err)
+	}
+	if lastBatch == 0 {
+		return nil
+	}
+
+	var batchRows []migrationRow
+	if err := m.db.NewSelect().
+		Model(&batchRows).
+		ModelTableExpr(m.tableName).
+		Where("batch = ?", lastBatch).
+		OrderExpr("id DESC").
+		Scan(ctx); err != nil {
+		return fmt.Errorf("migrate: load batch %d: %w", lastBatch, err)
+	}
+
+	return m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, row := range batchRows {
+			var migration *Migration
+			for _, candidate := range m.migrations {
+				if candidate.ID == row.ID {
+					migration = candidate
+					break
+				}
+			}
+			if migration == nil {
+				return fmt.Errorf("migrate: migration %q is not registered", row.ID)
+			}
+
+			if migration.Down != nil {
+				if err := migration.Down(ctx, tx); err != nil {
+					return fmt.Errorf("migrate: rollback %q: %w", migration.ID, err)
+				}
+			}
+
+			if _, err := tx.NewDelete().
+				Model((*migrationRow)(nil)).
+				ModelTableExpr(m.tableName).
+				Where("id = ?", migration.ID).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Status reports, for every registered migration, whether it has been
+// applied yet.
+type Status struct {
+	ID      string
+	Applied bool
+}
+
+// Status returns the apply state of every registered migration in ID order.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := m.init(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: init migrations table: %w", err)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: load applied migrations: %w", err)
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.sorted() {
+		statuses = append(statuses, Status{
+			ID:      migration.ID,
+			Applied: applied[migration.ID],
+		})
+	}
+	return statuses, nil
+}
@@ -0,0 +1,216 @@
+package bun
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun/internal"
+	"github.com/uptrace/bun/schema"
+)
+
+var errCreateIndexNameNotSet = errors.New("bun: CreateIndexQuery requires Index or IndexExpr")
+
+type CreateIndexQuery struct {
+	baseQuery
+
+	unique       bool
+	concurrently bool
+	ifNotExists  bool
+
+	index schema.QueryWithArgs
+	using schema.QueryWithArgs
+
+	columns []schema.QueryWithArgs
+	include []schema.QueryWithArgs
+	where   []schema.QueryWithArgs
+}
+
+func NewCreateIndexQuery(db *DB) *CreateIndexQuery {
+	q := &CreateIndexQuery{
+		baseQuery: baseQuery{
+			db:  db,
+			dbi: db.DB,
+		},
+	}
+	return q
+}
+
+func (q *CreateIndexQuery) Conn(db DBI) *CreateIndexQuery {
+	q.setDBI(db)
+	return q
+}
+
+func (q *CreateIndexQuery) Model(model interface{}) *CreateIndexQuery {
+	q.setTableModel(model)
+	return q
+}
+
+//------------------------------------------------------------------------------
+
+func (q *CreateIndexQuery) Unique() *CreateIndexQuery {
+	q.unique = true
+	return q
+}
+
+func (q *CreateIndexQuery) Concurrently() *CreateIndexQuery {
+	q.concurrently = true
+	return q
+}
+
+func (q *CreateIndexQuery) IfNotExists() *CreateIndexQuery {
+	q.ifNotExists = true
+	return q
+}
+
+func (q *CreateIndexQuery) Index(name string) *CreateIndexQuery {
+	q.index = schema.UnsafeIdent(name)
+	return q
+}
+
+func (q *CreateIndexQuery) IndexExpr(query string, args ...interface{}) *CreateIndexQuery {
+	q.index = schema.SafeQuery(query, args)
+	return q
+}
+
+func (q *CreateIndexQuery) On(table string) *CreateIndexQuery {
+	q.addTable(schema.UnsafeIdent(table))
+	return q
+}
+
+func (q *CreateIndexQuery) OnExpr(query string, args ...interface{}) *CreateIndexQuery {
+	q.addTable(schema.SafeQuery(query, args))
+	return q
+}
+
+func (q *CreateIndexQuery) Column(columns ...string) *CreateIndexQuery {
+	for _, column := range columns {
+		q.columns = append(q.columns, schema.UnsafeIdent(column))
+	}
+	return q
+}
+
+func (q *CreateIndexQuery) ColumnExpr(query string, args ...interface{}) *CreateIndexQuery {
+	q.columns = append(q.columns, schema.SafeQuery(query, args))
+	return q
+}
+
+func (q *CreateIndexQuery) Using(method string) *CreateIndexQuery {
+	q.using = schema.UnsafeIdent(method)
+	return q
+}
+
+func (q *CreateIndexQuery) Where(query string, args ...interface{}) *CreateIndexQuery {
+	q.where = append(q.where, schema.SafeQuery(query, args))
+	return q
+}
+
+// Include adds a Postgres covering-index INCLUDE clause.
+func (q *CreateIndexQuery) Include(columns ...string) *CreateIndexQuery {
+	for _, column := range columns {
+		q.include = append(q.include, schema.UnsafeIdent(column))
+	}
+	return q
+}
+
+//------------------------------------------------------------------------------
+
+func (q *CreateIndexQuery) AppendQuery(fmter schema.Formatter, b []byte) (_ []byte, err error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	if q.index.IsZero() {
+		return nil, errCreateIndexNameNotSet
+	}
+
+	b = append(b, "CREATE "...)
+	if q.unique {
+		b = append(b, "UNIQUE "...)
+	}
+	b = append(b, "INDEX "...)
+	if q.concurrently {
+		b = append(b, "CONCURRENTLY "...)
+	}
+	if q.ifNotExists {
+		b = append(b, "IF NOT EXISTS "...)
+	}
+
+	b, err = q.index.AppendQuery(fmter, b)
+	if err != nil {
+		return nil, err
+	}
+
+	b = append(b, " ON "...)
+	b, err = q.appendFirstTable(fmter, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.using.IsZero() {
+		b = append(b, " USING "...)
+		b, err = q.using.AppendQuery(fmter, b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b = append(b, " ("...)
+	for i, col := range q.columns {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b, err = col.AppendQuery(fmter, b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ")"...)
+
+	if len(q.include) > 0 {
+		b = append(b, " INCLUDE ("...)
+		for i, col := range q.include {
+			if i > 0 {
+				b = append(b, ", "...)
+			}
+			b, err = col.AppendQuery(fmter, b)
+			if err != nil {
+				return nil, err
+			}
+		}
+		b = append(b, ")"...)
+	}
+
+	if len(q.where) > 0 {
+		b = append(b, " WHERE "...)
+		for i, where := range q.where {
+			if i > 0 {
+				b = append(b, " AND "...)
+			}
+			b = append(b, "("...)
+			b, err = where.AppendQuery(fmter, b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ")"...)
+		}
+	}
+
+	return b, nil
+}
+
+//------------------------------------------------------------------------------
+
+func (q *CreateIndexQuery) Exec(ctx context.Context, dest ...interface{}) (sql.Result, error) {
+	bs := getByteSlice()
+	defer putByteSlice(bs)
+
+	queryBytes, err := q.AppendQuery(q.db.fmter, bs.b)
+	if err != nil {
+		return nil, err
+	}
+
+	bs.update(queryBytes)
+	query := internal.String(queryBytes)
+
+	return q.exec(ctx, q, query)
+}
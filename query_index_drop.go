@@ -0,0 +1,126 @@
+package bun
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun/dialect/feature"
+	"github.com/uptrace/bun/internal"
+	"github.com/uptrace/bun/schema"
+)
+
+var errDropIndexNameNotSet = errors.New("bun: DropIndexQuery requires Index or IndexExpr")
+var errDropIndexOnNotSet = errors.New("bun: DropIndexQuery requires On on this dialect")
+
+type DropIndexQuery struct {
+	baseQuery
+
+	concurrently bool
+	ifExists     bool
+
+	index schema.QueryWithArgs
+	on    schema.QueryWithArgs
+}
+
+func NewDropIndexQuery(db *DB) *DropIndexQuery {
+	q := &DropIndexQuery{
+		baseQuery: baseQuery{
+			db:  db,
+			dbi: db.DB,
+		},
+	}
+	return q
+}
+
+func (q *DropIndexQuery) Conn(db DBI) *DropIndexQuery {
+	q.setDBI(db)
+	return q
+}
+
+func (q *DropIndexQuery) Concurrently() *DropIndexQuery {
+	q.concurrently = true
+	return q
+}
+
+func (q *DropIndexQuery) IfExists() *DropIndexQuery {
+	q.ifExists = true
+	return q
+}
+
+func (q *DropIndexQuery) Index(name string) *DropIndexQuery {
+	q.index = schema.UnsafeIdent(name)
+	return q
+}
+
+func (q *DropIndexQuery) IndexExpr(query string, args ...interface{}) *DropIndexQuery {
+	q.index = schema.SafeQuery(query, args)
+	return q
+}
+
+// On sets the table the index belongs to. It is required on dialects (e.g.
+// MySQL) whose DROP INDEX syntax mandates "ON table_name", and ignored on
+// dialects (Postgres, SQLite) that address indexes by name alone.
+func (q *DropIndexQuery) On(table string) *DropIndexQuery {
+	q.on = schema.UnsafeIdent(table)
+	return q
+}
+
+func (q *DropIndexQuery) OnExpr(query string, args ...interface{}) *DropIndexQuery {
+	q.on = schema.SafeQuery(query, args)
+	return q
+}
+
+//------------------------------------------------------------------------------
+
+func (q *DropIndexQuery) AppendQuery(fmter schema.Formatter, b []byte) (_ []byte, err error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	if q.index.IsZero() {
+		return nil, errDropIndexNameNotSet
+	}
+	if q.on.IsZero() && q.db.features.Has(feature.DropIndexRequiresTable) {
+		return nil, errDropIndexOnNotSet
+	}
+
+	b = append(b, "DROP INDEX "...)
+	if q.concurrently {
+		b = append(b, "CONCURRENTLY "...)
+	}
+	if q.ifExists {
+		b = append(b, "IF EXISTS "...)
+	}
+
+	b, err = q.index.AppendQuery(fmter, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.on.IsZero() && q.db.features.Has(feature.DropIndexRequiresTable) {
+		b = append(b, " ON "...)
+		b, err = q.on.AppendQuery(fmter, b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+//------------------------------------------------------------------------------
+
+func (q *DropIndexQuery) Exec(ctx context.Context, dest ...interface{}) (sql.Result, error) {
+	bs := getByteSlice()
+	defer putByteSlice(bs)
+
+	queryBytes, err := q.AppendQuery(q.db.fmter, bs.b)
+	if err != nil {
+		return nil, err
+	}
+
+	bs.update(queryBytes)
+	query := internal.String(queryBytes)
+
+	return q.exec(ctx, q, query)
+}
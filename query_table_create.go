@@ -3,6 +3,7 @@ package bun
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"sort"
 	"strconv"
 
@@ -18,12 +19,20 @@ type CreateTableQuery struct {
 	temp        bool
 	ifNotExists bool
 	varchar     int
+	withFKs     bool
+	withIndexes bool
 
 	fks         []schema.QueryWithArgs
+	checks      []tableCheck
 	partitionBy schema.QueryWithArgs
 	tablespace  schema.QueryWithArgs
 }
 
+type tableCheck struct {
+	name  string
+	query schema.QueryWithArgs
+}
+
 func NewCreateTableQuery(db *DB) *CreateTableQuery {
 	q := &CreateTableQuery{
 		baseQuery: baseQuery{
@@ -85,6 +94,37 @@ func (q *CreateTableQuery) ForeignKey(query string, args ...interface{}) *Create
 	return q
 }
 
+// WithForeignKeys instructs CreateTable to also emit a FOREIGN KEY constraint
+// for every belongs-to relation declared on the model, in addition to any
+// constraints added explicitly via ForeignKey. The ON DELETE and ON UPDATE
+// actions can be customized per-relation with the `on_delete` and
+// `on_update` bun tag options, e.g. `bun:"rel:belongs-to,join:author_id=id,on_delete:CASCADE"`.
+//
+// has-one relations are skipped: the foreign key column for a has-one lives
+// on the *other* table (e.g. User.Profile `bun:"rel:has-one,join:id=user_id"`
+// puts the FK on profiles, not users), so the constraint belongs in that
+// model's own WithForeignKeys call, not here.
+func (q *CreateTableQuery) WithForeignKeys() *CreateTableQuery {
+	q.withFKs = true
+	return q
+}
+
+// Check adds a table-level CHECK constraint, rendered as
+// ", CONSTRAINT <name> CHECK (<expr>)".
+func (q *CreateTableQuery) Check(name, expr string, args ...interface{}) *CreateTableQuery {
+	q.checks = append(q.checks, tableCheck{name: name, query: schema.SafeQuery(expr, args)})
+	return q
+}
+
+// WithIndexes instructs Exec to also run, on the same connection right after
+// the table is created, a CREATE INDEX statement for every index name found
+// in the model's `idx:` tags, e.g. `bun:"idx:idx_users_email,unique"`.
+// Fields whose tags share a name are combined into one composite index.
+func (q *CreateTableQuery) WithIndexes() *CreateTableQuery {
+	q.withIndexes = true
+	return q
+}
+
 func (q *CreateTableQuery) AppendQuery(fmter schema.Formatter, b []byte) (_ []byte, err error) {
 	if q.err != nil {
 		return nil, q.err
@@ -115,6 +155,23 @@ func (q *CreateTableQuery) AppendQuery(fmter schema.Formatter, b []byte) (_ []by
 
 		b = append(b, field.SQLName...)
 		b = append(b, " "...)
+
+		if generated, ok := field.Tag.Options["generated"]; ok && len(generated) > 0 {
+			if !q.db.features.Has(feature.GeneratedColumns) {
+				return nil, fmt.Errorf("bun: %s: dialect does not support generated columns", q.db.dialect.Name())
+			}
+			b = q.appendSQLType(b, field)
+			b = append(b, " GENERATED ALWAYS AS ("...)
+			b = append(b, generated[0]...)
+			b = append(b, ")"...)
+			if _, stored := field.Tag.Options["stored"]; stored {
+				b = append(b, " STORED"...)
+			} else {
+				b = append(b, " VIRTUAL"...)
+			}
+			continue
+		}
+
 		b = q.appendSQLType(b, field)
 		if field.NotNull {
 			b = append(b, " NOT NULL"...)
@@ -126,6 +183,11 @@ func (q *CreateTableQuery) AppendQuery(fmter schema.Formatter, b []byte) (_ []by
 			b = append(b, " DEFAULT "...)
 			b = append(b, field.SQLDefault...)
 		}
+		if check, ok := field.Tag.Options["check"]; ok && len(check) > 0 {
+			b = append(b, " CHECK ("...)
+			b = append(b, check[0]...)
+			b = append(b, ")"...)
+		}
 	}
 
 	b = q.appendPKConstraint(b, q.table.PKs)
@@ -135,6 +197,18 @@ func (q *CreateTableQuery) AppendQuery(fmter schema.Formatter, b []byte) (_ []by
 		return nil, err
 	}
 
+	b, err = q.appendCheckConstraints(fmter, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.withFKs {
+		b, err = q.appendRelationFKs(fmter, b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	b = append(b, ")"...)
 
 	if !q.partitionBy.IsZero() {
@@ -217,6 +291,62 @@ func (q *CreateTableQuery) appenFKConstraints(
 	return b, nil
 }
 
+func (q *CreateTableQuery) appendCheckConstraints(
+	fmter schema.Formatter, b []byte,
+) (_ []byte, err error) {
+	for _, check := range q.checks {
+		if check.name != "" {
+			b = append(b, ", CONSTRAINT "...)
+			b = fmter.AppendIdent(b, check.name)
+			b = append(b, " CHECK ("...)
+		} else {
+			b = append(b, ", CHECK ("...)
+		}
+		b, err = check.query.AppendQuery(fmter, b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ")"...)
+	}
+	return b, nil
+}
+
+func (q *CreateTableQuery) appendRelationFKs(fmter schema.Formatter, b []byte) (_ []byte, err error) {
+	names := make([]string, 0, len(q.table.Relations))
+	for name, rel := range q.table.Relations {
+		// Only belongs-to relations keep their foreign key on this table.
+		// A has-one's FK column lives on the join table, so it is emitted
+		// by that model's own WithForeignKeys call instead.
+		if rel.Type != schema.BelongsToRelation {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rel := q.table.Relations[name]
+		b = append(b, ", FOREIGN KEY ("...)
+		b = appendColumns(b, "", rel.BaseFields)
+		b = append(b, ") REFERENCES "...)
+		b = append(b, rel.JoinTable.SQLName...)
+		b = append(b, " ("...)
+		b = appendColumns(b, "", rel.JoinFields)
+		b = append(b, ")"...)
+
+		if onDelete := rel.Field.Tag.Options["on_delete"]; len(onDelete) > 0 {
+			b = append(b, " ON DELETE "...)
+			b = append(b, onDelete[0]...)
+		}
+		if onUpdate := rel.Field.Tag.Options["on_update"]; len(onUpdate) > 0 {
+			b = append(b, " ON UPDATE "...)
+			b = append(b, onUpdate[0]...)
+		}
+	}
+
+	return b, nil
+}
+
 func (q *CreateTableQuery) appendPKConstraint(b []byte, pks []*schema.Field) []byte {
 	if len(pks) == 0 {
 		return b
@@ -257,9 +387,80 @@ func (q *CreateTableQuery) Exec(ctx context.Context, dest ...interface{}) (res s
 		}
 	}
 
+	if q.withIndexes {
+		if err := q.execIndexes(ctx); err != nil {
+			return res, err
+		}
+	}
+
 	return res, nil
 }
 
+// tagIndex is a CREATE INDEX statement discovered from one or more `idx:`
+// struct tags sharing the same index name, e.g.
+// `bun:",idx:idx_users_ln_fn"` on both LastName and FirstName.
+type tagIndex struct {
+	name   string
+	unique bool
+	fields []*schema.Field
+}
+
+// discoverIndexes walks the model's fields for `idx:<name>` tag options and
+// groups same-named occurrences into a single composite index. The order
+// returned follows struct field declaration order, which keeps it
+// deterministic across runs.
+func (q *CreateTableQuery) discoverIndexes() []tagIndex {
+	byName := make(map[string]*tagIndex)
+	var order []string
+
+	for _, field := range q.table.Fields {
+		names := field.Tag.Options["idx"]
+		if len(names) == 0 {
+			continue
+		}
+
+		name := names[0]
+		idx, ok := byName[name]
+		if !ok {
+			idx = &tagIndex{name: name}
+			byName[name] = idx
+			order = append(order, name)
+		}
+		if _, unique := field.Tag.Options["unique"]; unique {
+			idx.unique = true
+		}
+		idx.fields = append(idx.fields, field)
+	}
+
+	indexes := make([]tagIndex, 0, len(order))
+	for _, name := range order {
+		indexes = append(indexes, *byName[name])
+	}
+	return indexes
+}
+
+func (q *CreateTableQuery) execIndexes(ctx context.Context) error {
+	for _, idx := range q.discoverIndexes() {
+		iq := NewCreateIndexQuery(q.db).
+			Conn(q.dbi).
+			IfNotExists().
+			Index(idx.name).
+			OnExpr(string(q.table.SQLName))
+		for _, field := range idx.fields {
+			iq = iq.ColumnExpr(string(field.SQLName))
+		}
+		if idx.unique {
+			iq = iq.Unique()
+		}
+
+		if _, err := iq.Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (q *CreateTableQuery) beforeCreateTableHook(ctx context.Context) error {
 	if hook, ok := q.table.ZeroIface.(BeforeCreateTableHook); ok {
 		if err := hook.BeforeCreateTable(ctx, q); err != nil {
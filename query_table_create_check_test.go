@@ -0,0 +1,164 @@
+package bun_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/mysqldialect"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/schema"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type checkProduct struct {
+	ID    int64   `bun:"id,pk,autoincrement"`
+	Price float64 `bun:"price,notnull,check:price > 0"`
+}
+
+func TestCreateTableQuery_Check(t *testing.T) {
+	dialects := []schema.Dialect{
+		pgdialect.New(),
+		mysqldialect.New(),
+		sqlitedialect.New(),
+	}
+
+	for _, dialect := range dialects {
+		db := bun.NewDB(&sql.DB{}, dialect)
+
+		query, err := db.NewCreateTable().
+			Model((*checkProduct)(nil)).
+			Check("check_products_in_stock", "price < 1000000").
+			AppendQuery(db.Formatter(), nil)
+		if err != nil {
+			t.Fatalf("%s: %s", dialect.Name(), err)
+		}
+
+		if !strings.Contains(string(query), `CHECK (price > 0)`) {
+			t.Fatalf("%s: missing column-level check in %q", dialect.Name(), query)
+		}
+		if !strings.Contains(string(query), `CONSTRAINT "check_products_in_stock" CHECK (price < 1000000)`) {
+			t.Fatalf("%s: missing table-level check in %q", dialect.Name(), query)
+		}
+	}
+}
+
+type generatedFullName struct {
+	ID       int64  `bun:"id,pk,autoincrement"`
+	First    string `bun:"first_name,notnull"`
+	Last     string `bun:"last_name,notnull"`
+	FullName string `bun:"full_name,generated:first_name || ' ' || last_name,stored"`
+}
+
+func TestCreateTableQuery_GeneratedColumn(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, sqlitedialect.New())
+
+	query, err := db.NewCreateTable().
+		Model((*generatedFullName)(nil)).
+		AppendQuery(db.Formatter(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(query), "GENERATED ALWAYS AS (first_name || ' ' || last_name) STORED") {
+		t.Fatalf("query %q missing STORED generated column", query)
+	}
+}
+
+// TestCreateTableQuery_CheckEnforced round-trips an insert against a real
+// (in-memory) SQLite database to confirm the CHECK constraint is actually
+// enforced by the engine, not just rendered into the DDL string.
+func TestCreateTableQuery_CheckEnforced(t *testing.T) {
+	sqldb, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqldb.Close()
+
+	db := bun.NewDB(sqldb, sqlitedialect.New())
+	ctx := context.Background()
+
+	if _, err := db.NewCreateTable().Model((*checkProduct)(nil)).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.NewInsert().Model(&checkProduct{Price: 10}).Exec(ctx); err != nil {
+		t.Fatalf("expected valid row to insert, got %s", err)
+	}
+
+	if _, err := db.NewInsert().Model(&checkProduct{Price: -1}).Exec(ctx); err == nil {
+		t.Fatal("expected CHECK constraint violation for negative price")
+	}
+}
+
+// TestCreateTableQuery_CheckEnforcedPG is the Postgres counterpart of
+// TestCreateTableQuery_CheckEnforced. It requires a reachable server and is
+// skipped unless PG_TEST_DSN is set, matching how this package's other
+// dialect-specific integration tests opt in to a live database.
+func TestCreateTableQuery_CheckEnforcedPG(t *testing.T) {
+	dsn := os.Getenv("PG_TEST_DSN")
+	if dsn == "" {
+		t.Skip("PG_TEST_DSN not set; skipping Postgres round-trip test")
+	}
+
+	sqldb, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqldb.Close()
+
+	db := bun.NewDB(sqldb, pgdialect.New())
+	ctx := context.Background()
+
+	if _, err := db.NewCreateTable().Model((*checkProduct)(nil)).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.NewInsert().Model(&checkProduct{Price: 10}).Exec(ctx); err != nil {
+		t.Fatalf("expected valid row to insert, got %s", err)
+	}
+
+	if _, err := db.NewInsert().Model(&checkProduct{Price: -1}).Exec(ctx); err == nil {
+		t.Fatal("expected CHECK constraint violation for negative price")
+	}
+}
+
+// TestCreateTableQuery_CheckEnforcedMySQL is the MySQL counterpart of
+// TestCreateTableQuery_CheckEnforced. CHECK constraints are only enforced by
+// the server starting with MySQL 8.0.16; older servers parse but ignore
+// them. It requires a reachable server and is skipped unless
+// MYSQL_TEST_DSN is set.
+func TestCreateTableQuery_CheckEnforcedMySQL(t *testing.T) {
+	dsn := os.Getenv("MYSQL_TEST_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_TEST_DSN not set; skipping MySQL round-trip test")
+	}
+
+	sqldb, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqldb.Close()
+
+	db := bun.NewDB(sqldb, mysqldialect.New())
+	ctx := context.Background()
+
+	if _, err := db.NewCreateTable().Model((*checkProduct)(nil)).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.NewInsert().Model(&checkProduct{Price: 10}).Exec(ctx); err != nil {
+		t.Fatalf("expected valid row to insert, got %s", err)
+	}
+
+	if _, err := db.NewInsert().Model(&checkProduct{Price: -1}).Exec(ctx); err == nil {
+		t.Fatal("expected CHECK constraint violation for negative price")
+	}
+}
@@ -0,0 +1,106 @@
+package bun_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/mysqldialect"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/schema"
+)
+
+type fkAuthor struct {
+	ID int64 `bun:"id,pk,autoincrement"`
+}
+
+type fkBook struct {
+	ID       int64     `bun:"id,pk,autoincrement"`
+	AuthorID int64     `bun:"author_id,notnull"`
+	Author   *fkAuthor `bun:"rel:belongs-to,join:author_id=id,on_delete:CASCADE,on_update:RESTRICT"`
+}
+
+type fkUser struct {
+	ID      int64      `bun:"id,pk,autoincrement"`
+	Profile *fkProfile `bun:"rel:has-one,join:id=user_id"`
+}
+
+type fkProfile struct {
+	ID     int64   `bun:"id,pk,autoincrement"`
+	UserID int64   `bun:"user_id,notnull"`
+	User   *fkUser `bun:"rel:belongs-to,join:user_id=id"`
+}
+
+// TestCreateTableQuery_HasOneSkipped asserts that a has-one relation does not
+// emit a (backwards) FOREIGN KEY constraint on the base table: the FK column
+// lives on the join table, so WithForeignKeys must be called on that model
+// instead.
+func TestCreateTableQuery_HasOneSkipped(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, sqlitedialect.New())
+
+	userQuery, err := db.NewCreateTable().
+		Model((*fkUser)(nil)).
+		WithForeignKeys().
+		AppendQuery(db.Formatter(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(userQuery), "FOREIGN KEY") {
+		t.Fatalf("has-one relation must not emit a FOREIGN KEY on the base table, got %q", userQuery)
+	}
+
+	profileQuery, err := db.NewCreateTable().
+		Model((*fkProfile)(nil)).
+		WithForeignKeys().
+		AppendQuery(db.Formatter(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `FOREIGN KEY ("user_id") REFERENCES "fk_users" ("id")`
+	if !strings.Contains(string(profileQuery), want) {
+		t.Fatalf("query %q does not contain %q", profileQuery, want)
+	}
+}
+
+func TestCreateTableQuery_WithForeignKeys(t *testing.T) {
+	dialects := []schema.Dialect{
+		pgdialect.New(),
+		mysqldialect.New(),
+		sqlitedialect.New(),
+	}
+
+	for _, dialect := range dialects {
+		db := bun.NewDB(&sql.DB{}, dialect)
+
+		for i := 0; i < 3; i++ {
+			query, err := db.NewCreateTable().
+				Model((*fkBook)(nil)).
+				WithForeignKeys().
+				AppendQuery(db.Formatter(), nil)
+			if err != nil {
+				t.Fatalf("%s: %s", dialect.Name(), err)
+			}
+
+			want := `FOREIGN KEY ("author_id") REFERENCES "fk_authors" ("id") ON DELETE CASCADE ON UPDATE RESTRICT`
+			if !strings.Contains(string(query), want) {
+				t.Fatalf("%s: query %q does not contain %q", dialect.Name(), query, want)
+			}
+
+			// Re-running must produce byte-identical output: the relation
+			// FK clause order is not allowed to depend on map iteration.
+			query2, err := db.NewCreateTable().
+				Model((*fkBook)(nil)).
+				WithForeignKeys().
+				AppendQuery(db.Formatter(), nil)
+			if err != nil {
+				t.Fatalf("%s: %s", dialect.Name(), err)
+			}
+			if string(query) != string(query2) {
+				t.Fatalf("%s: FK clause order is not deterministic", dialect.Name())
+			}
+		}
+	}
+}
+